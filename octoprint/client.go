@@ -0,0 +1,142 @@
+package octoprint
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultTimeout is the request timeout applied when a Client has none set
+// explicitly.
+const DefaultTimeout = 30 * time.Second
+
+// Client is an OctoPrint REST API client. The zero value is not usable; use
+// NewClient.
+type Client struct {
+	Endpoint string
+	APIKey   string
+
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+// NewClient returns a Client talking to the OctoPrint instance at endpoint,
+// authenticating with apiKey. The client keeps a cookie jar so a
+// LoginRequest's session cookie survives to authenticate later requests,
+// such as EventStream's websocket dial.
+func NewClient(endpoint, apiKey string) *Client {
+	jar, _ := cookiejar.New(nil)
+
+	return &Client{
+		Endpoint:   endpoint,
+		APIKey:     apiKey,
+		httpClient: &http.Client{Jar: jar},
+		timeout:    DefaultTimeout,
+	}
+}
+
+// SetTimeout sets the default deadline applied to a request when the
+// context passed to its DoContext call doesn't already carry one. A timeout
+// of 0 disables the default.
+func (c *Client) SetTimeout(d time.Duration) {
+	c.timeout = d
+}
+
+// doRequestContext issues a request against the OctoPrint API, aborting if
+// ctx is canceled or its deadline expires before a response is received. If
+// ctx carries no deadline of its own, the client's default timeout (see
+// SetTimeout) is applied as a fallback.
+func (c *Client) doRequestContext(ctx context.Context, method, uri string, body io.Reader) ([]byte, error) {
+	contentType := ""
+	if body != nil {
+		contentType = "application/json"
+	}
+
+	return c.doRequestContextWithContentType(ctx, method, uri, body, contentType)
+}
+
+// doRequestContextWithContentType behaves like doRequestContext but lets the
+// caller override the Content-Type header, which multipart file uploads
+// need to set to their own boundary-delimited value.
+func (c *Client) doRequestContextWithContentType(ctx context.Context, method, uri string, body io.Reader, contentType string) ([]byte, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.Endpoint+uri, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "building request")
+	}
+
+	for k, v := range c.authHeader() {
+		req.Header[k] = v
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "doing request")
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response")
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, errors.Errorf("unexpected status %q calling %s %s", resp.Status, method, uri)
+	}
+
+	return b, nil
+}
+
+// endpoint resolves uri against the client's base endpoint.
+func (c *Client) endpoint(uri string) *url.URL {
+	u, _ := url.Parse(c.Endpoint + uri)
+	return u
+}
+
+// authHeader returns the headers used to authenticate a plain REST request.
+// It only carries the API key: requests made through c.httpClient pick up
+// the session cookie automatically via its CookieJar, so adding it here too
+// would just duplicate the Cookie header.
+func (c *Client) authHeader() http.Header {
+	return http.Header{"X-Api-Key": []string{c.APIKey}}
+}
+
+// websocketAuthHeader returns the headers EventStream should send when
+// dialing OctoPrint's SockJS websocket directly, which bypasses
+// c.httpClient (and therefore its CookieJar) entirely. It carries both the
+// API key and the session cookie a prior LoginRequest obtained for uri, the
+// same way a browser would replay it on the websocket handshake.
+func (c *Client) websocketAuthHeader(uri string) http.Header {
+	header := c.authHeader()
+
+	if c.httpClient.Jar == nil {
+		return header
+	}
+
+	cookies := c.httpClient.Jar.Cookies(c.endpoint(uri))
+	if len(cookies) == 0 {
+		return header
+	}
+
+	req := &http.Request{Header: http.Header{}}
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	header["Cookie"] = req.Header["Cookie"]
+
+	return header
+}
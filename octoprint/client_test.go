@@ -0,0 +1,42 @@
+package octoprint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientDefaultTimeoutAppliesWhenContextHasNoDeadline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key")
+	c.SetTimeout(5 * time.Millisecond)
+
+	_, err := c.doRequestContext(context.Background(), "GET", "/", nil)
+	if err == nil {
+		t.Fatal("expected the default timeout to abort the request, got nil error")
+	}
+}
+
+func TestClientDefaultTimeoutDoesNotOverrideCallerDeadline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key")
+	c.SetTimeout(5 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if _, err := c.doRequestContext(ctx, "GET", "/", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package octoprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// URIConnection is the connection sub-resource of the OctoPrint REST API.
+const URIConnection = "/api/connection"
+
+// ConnectionRequest retrieves the current connection state between
+// OctoPrint and the printer.
+type ConnectionRequest struct{}
+
+// ConnectionResponse is the response to a ConnectionRequest.
+type ConnectionResponse struct {
+	Current struct {
+		State          string `json:"state"`
+		Port           string `json:"port"`
+		BaudRate       int    `json:"baudrate"`
+		PrinterProfile string `json:"printerProfile"`
+	} `json:"current"`
+	Options struct {
+		Ports           []string `json:"ports"`
+		BaudRates       []int    `json:"baudrates"`
+		PrinterProfiles []string `json:"printerProfiles"`
+	} `json:"options"`
+}
+
+// Do sends an API request and returns the API response.
+func (cmd *ConnectionRequest) Do(c *Client) (*ConnectionResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *ConnectionRequest) DoContext(ctx context.Context, c *Client) (*ConnectionResponse, error) {
+	b, err := c.doRequestContext(ctx, "GET", URIConnection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ConnectionResponse{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ConnectCommand instructs OctoPrint to connect to the printer.
+type ConnectCommand struct {
+	Port           string `json:"port,omitempty"`
+	BaudRate       int    `json:"baudrate,omitempty"`
+	PrinterProfile string `json:"printerProfile,omitempty"`
+	// AutoConnect requests OctoPrint auto-detect port and baud rate.
+	AutoConnect bool `json:"autoconnect,omitempty"`
+}
+
+// Do sends an API request and returns an error if any.
+func (cmd *ConnectCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *ConnectCommand) DoContext(ctx context.Context, c *Client) error {
+	b := bytes.NewBuffer(nil)
+	if err := json.NewEncoder(b).Encode(struct {
+		Command string `json:"command"`
+		ConnectCommand
+	}{
+		Command:        "connect",
+		ConnectCommand: *cmd,
+	}); err != nil {
+		return err
+	}
+
+	_, err := c.doRequestContext(ctx, "POST", URIConnection, b)
+	return err
+}
+
+// DisconnectCommand instructs OctoPrint to disconnect from the printer.
+type DisconnectCommand struct{}
+
+// Do sends an API request and returns an error if any.
+func (cmd *DisconnectCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *DisconnectCommand) DoContext(ctx context.Context, c *Client) error {
+	b := bytes.NewBuffer(nil)
+	if err := json.NewEncoder(b).Encode(struct {
+		Command string `json:"command"`
+	}{
+		Command: "disconnect",
+	}); err != nil {
+		return err
+	}
+
+	_, err := c.doRequestContext(ctx, "POST", URIConnection, b)
+	return err
+}
@@ -0,0 +1,290 @@
+package octoprint
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// URISockJSWebsocket is the SockJS websocket endpoint OctoPrint exposes for
+// its push-based event channel.
+const URISockJSWebsocket = "/sockjs/websocket"
+
+// EventType identifies the kind of payload carried by an Event.
+type EventType string
+
+const (
+	// EventCurrent carries the same data as ToolCommand/PrinterCommand but is
+	// pushed whenever the printer's state changes.
+	EventCurrent EventType = "current"
+	// EventHistory carries a batch of historical temperature/state data sent
+	// once, right after the connection is established.
+	EventHistory EventType = "history"
+	// EventEvent carries a named OctoPrint server event (PrintStarted,
+	// PrintDone, Error, ...).
+	EventEvent EventType = "event"
+	// EventPlugin carries a message emitted by an OctoPrint plugin.
+	EventPlugin EventType = "plugin"
+	// EventSlicingProgress carries slicing progress updates.
+	EventSlicingProgress EventType = "slicingProgress"
+	// EventError is synthesized locally, never sent by OctoPrint, and
+	// dispatched when a frame's payload doesn't unmarshal into the struct
+	// its Type implies. Register a handler for it to be notified of wire
+	// format mismatches instead of silently receiving a zero-valued Event.
+	EventError EventType = "error"
+)
+
+// Event is a single message received over the event stream. Payload holds
+// the raw JSON payload (for EventEvent, the named event's own payload); of
+// the typed fields only the one matching Type is populated. When Type is
+// EventError, only Err is set.
+type Event struct {
+	Type            EventType
+	Current         *CurrentStateEvent
+	History         []*History
+	Name            string
+	Payload         json.RawMessage
+	Plugin          *PluginMessage
+	SlicingProgress *SlicingProgress
+	Err             error
+}
+
+// CurrentStateEvent mirrors the "current" payload pushed over the event
+// stream, which carries the same shape as ToolResponse.Current plus job/
+// printer state OctoPrint bundles alongside it.
+type CurrentStateEvent struct {
+	Temps map[string]CurrentState `json:"temps"`
+	State json.RawMessage         `json:"state"`
+	Job   json.RawMessage         `json:"job"`
+}
+
+// PluginMessage is a message emitted by an OctoPrint plugin over the event
+// stream.
+type PluginMessage struct {
+	Plugin string          `json:"plugin"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// SlicingProgress reports the progress of an in-flight slicing job.
+type SlicingProgress struct {
+	Slicer              string  `json:"slicer"`
+	SourceLocation      string  `json:"source_location"`
+	SourcePath          string  `json:"source_path"`
+	DestinationLocation string  `json:"dest_location"`
+	DestinationPath     string  `json:"dest_path"`
+	Progress            float64 `json:"progress"`
+}
+
+// EventHandler is invoked for every Event received on the stream. Handlers
+// are called from the stream's own goroutine and must not block.
+type EventHandler func(*Event)
+
+// EventStream maintains a push-based connection to OctoPrint's SockJS
+// websocket channel and dispatches typed Events to registered handlers,
+// reconnecting automatically if the connection drops.
+//
+// It is the push counterpart to the polling commands (ToolCommand.Do and
+// friends): instead of the UI ticking a timer and issuing REST requests,
+// panels can register a handler with On and be notified as soon as
+// OctoPrint pushes new data.
+type EventStream struct {
+	client *Client
+
+	mu       sync.Mutex
+	handlers map[EventType][]EventHandler
+	conn     *websocket.Conn
+	closed   chan struct{}
+	closeOne sync.Once
+}
+
+// NewEventStream creates an EventStream bound to c. Call Connect to open the
+// websocket and start dispatching events.
+func NewEventStream(c *Client) *EventStream {
+	return &EventStream{
+		client:   c,
+		handlers: map[EventType][]EventHandler{},
+		closed:   make(chan struct{}),
+	}
+}
+
+// On registers handler to be invoked whenever an Event of the given type is
+// received. Multiple handlers may be registered for the same type.
+func (s *EventStream) On(t EventType, handler EventHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[t] = append(s.handlers[t], handler)
+}
+
+// Connect opens the SockJS websocket, performs the passive-login handshake
+// and starts reading events in a background goroutine. If the connection
+// drops, Connect reconnects automatically using exponential backoff until
+// Close is called.
+func (s *EventStream) Connect() error {
+	if err := s.dial(); err != nil {
+		return err
+	}
+
+	go s.readLoop()
+	return nil
+}
+
+// Close shuts down the event stream and stops reconnection attempts. It is
+// safe to call Close more than once.
+func (s *EventStream) Close() error {
+	var err error
+	s.closeOne.Do(func() {
+		close(s.closed)
+
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+
+		if conn != nil {
+			err = conn.Close()
+		}
+	})
+	return err
+}
+
+func (s *EventStream) dial() error {
+	if _, err := (&LoginRequest{Passive: true}).Do(s.client); err != nil {
+		return errors.Wrap(err, "passive login")
+	}
+
+	url := s.client.endpoint(URISockJSWebsocket)
+	url.Scheme = websocketScheme(url.Scheme)
+
+	header := s.client.websocketAuthHeader(URISockJSWebsocket)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url.String(), header)
+	if err != nil {
+		return errors.Wrap(err, "dial websocket")
+	}
+
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	return nil
+}
+
+func websocketScheme(scheme string) string {
+	if scheme == "https" {
+		return "wss"
+	}
+	return "ws"
+}
+
+func (s *EventStream) readLoop() {
+	backoff := time.Second
+
+	for {
+		select {
+		case <-s.closed:
+			return
+		default:
+		}
+
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if s.reconnect(&backoff) {
+				continue
+			}
+			return
+		}
+
+		backoff = time.Second
+		s.dispatch(message)
+	}
+}
+
+// maxBackoff caps the exponential backoff applied between reconnect
+// attempts.
+const maxBackoff = 30 * time.Second
+
+// nextBackoff doubles d, capped at maxBackoff.
+func nextBackoff(d time.Duration) time.Duration {
+	return time.Duration(math.Min(float64(d)*2, float64(maxBackoff)))
+}
+
+// reconnect waits out backoff and redials. It reports false if the stream
+// was closed while waiting.
+func (s *EventStream) reconnect(backoff *time.Duration) bool {
+	select {
+	case <-s.closed:
+		return false
+	case <-time.After(*backoff):
+	}
+
+	*backoff = nextBackoff(*backoff)
+
+	if err := s.dial(); err != nil {
+		return true
+	}
+
+	return true
+}
+
+// emit calls every handler registered for event.Type with event.
+func (s *EventStream) emit(event *Event) {
+	s.mu.Lock()
+	handlers := append([]EventHandler(nil), s.handlers[event.Type]...)
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+func (s *EventStream) dispatch(message []byte) {
+	var frame map[string]json.RawMessage
+	if err := json.Unmarshal(message, &frame); err != nil {
+		return
+	}
+
+	for rawType, rawPayload := range frame {
+		t := EventType(rawType)
+		event := &Event{Type: t, Payload: rawPayload}
+
+		var err error
+		switch t {
+		case EventCurrent:
+			event.Current = &CurrentStateEvent{}
+			err = json.Unmarshal(rawPayload, event.Current)
+		case EventHistory:
+			err = json.Unmarshal(rawPayload, &event.History)
+		case EventPlugin:
+			event.Plugin = &PluginMessage{}
+			err = json.Unmarshal(rawPayload, event.Plugin)
+		case EventSlicingProgress:
+			event.SlicingProgress = &SlicingProgress{}
+			err = json.Unmarshal(rawPayload, event.SlicingProgress)
+		case EventEvent:
+			var named struct {
+				Type    string          `json:"type"`
+				Payload json.RawMessage `json:"payload"`
+			}
+			err = json.Unmarshal(rawPayload, &named)
+			event.Name = named.Type
+			event.Payload = named.Payload
+		}
+
+		if err != nil {
+			s.emit(&Event{
+				Type: EventError,
+				Err:  errors.Wrapf(err, "unmarshal %q event", t),
+			})
+			continue
+		}
+
+		s.emit(event)
+	}
+}
@@ -0,0 +1,60 @@
+package octoprint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventStreamDispatchCurrent(t *testing.T) {
+	s := NewEventStream(nil)
+
+	var got *CurrentStateEvent
+	s.On(EventCurrent, func(e *Event) { got = e.Current })
+
+	s.dispatch([]byte(`{"current":{"temps":{"tool0":{"actual":200}}}}`))
+
+	if got == nil {
+		t.Fatal("expected a Current event to be dispatched")
+	}
+	if got.Temps["tool0"].Actual != 200 {
+		t.Fatalf("got actual temp %v, want 200", got.Temps["tool0"].Actual)
+	}
+}
+
+func TestEventStreamDispatchUnmarshalErrorSurfaced(t *testing.T) {
+	s := NewEventStream(nil)
+
+	var errEvent *Event
+	s.On(EventError, func(e *Event) { errEvent = e })
+
+	var currentCalled bool
+	s.On(EventCurrent, func(e *Event) { currentCalled = true })
+
+	// "temps" is an array instead of the expected object, so it fails to
+	// unmarshal into CurrentStateEvent.
+	s.dispatch([]byte(`{"current":{"temps":[1,2,3]}}`))
+
+	if currentCalled {
+		t.Fatal("EventCurrent handler should not fire on a malformed payload")
+	}
+	if errEvent == nil || errEvent.Err == nil {
+		t.Fatal("expected an EventError with a non-nil Err")
+	}
+}
+
+func TestNextBackoffDoublesAndCaps(t *testing.T) {
+	cases := []struct {
+		in   time.Duration
+		want time.Duration
+	}{
+		{time.Second, 2 * time.Second},
+		{20 * time.Second, maxBackoff},
+		{maxBackoff, maxBackoff},
+	}
+
+	for _, c := range cases {
+		if got := nextBackoff(c.in); got != c.want {
+			t.Errorf("nextBackoff(%v) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
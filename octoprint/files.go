@@ -0,0 +1,215 @@
+package octoprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// URIFiles is the files sub-resource of the OctoPrint REST API.
+const URIFiles = "/api/files"
+
+// Location is a storage location files can reside in, either on the
+// printer's SD card or in OctoPrint's local library.
+type Location string
+
+const (
+	// Local is OctoPrint's own file storage.
+	Local Location = "local"
+	// SDCard is the printer's SD card.
+	SDCard Location = "sdcard"
+)
+
+// FilesRequest retrieves a listing of the files available in location. An
+// empty location lists all locations; Path narrows the listing to a
+// subfolder.
+type FilesRequest struct {
+	Location Location
+	Path     string
+	// Recursive, if true, also returns files in sub-folders.
+	Recursive bool
+}
+
+// FilesResponse is the response to a FilesRequest.
+type FilesResponse struct {
+	Files []FileInformation `json:"files"`
+	Free  int64             `json:"free"`
+	Total int64             `json:"total"`
+}
+
+// FileInformation describes a single file or folder.
+type FileInformation struct {
+	Name   string         `json:"name"`
+	Path   string         `json:"path"`
+	Type   string         `json:"type"`
+	Origin string         `json:"origin"`
+	Size   int64          `json:"size"`
+	Date   int64          `json:"date"`
+	Gcode  *GcodeAnalysis `json:"gcodeAnalysis,omitempty"`
+}
+
+// GcodeAnalysis is the subset of OctoPrint's gcode analysis result that's
+// useful to display before printing.
+type GcodeAnalysis struct {
+	EstimatedPrintTime float64 `json:"estimatedPrintTime"`
+	Filament           map[string]struct {
+		Length float64 `json:"length"`
+		Volume float64 `json:"volume"`
+	} `json:"filament"`
+}
+
+func (cmd *FilesRequest) uri() (string, error) {
+	if cmd.Location == "" && cmd.Path != "" {
+		return "", errors.New("octoprint: FilesRequest.Path requires a Location, since Path is only meaningful within one")
+	}
+
+	uri := URIFiles
+	if cmd.Location != "" {
+		uri += "/" + string(cmd.Location)
+	}
+	if cmd.Path != "" {
+		uri += "/" + cmd.Path
+	}
+
+	return fmt.Sprintf("%s?recursive=%t", uri, cmd.Recursive), nil
+}
+
+// Do sends an API request and returns the API response.
+func (cmd *FilesRequest) Do(c *Client) (*FilesResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *FilesRequest) DoContext(ctx context.Context, c *Client) (*FilesResponse, error) {
+	uri, err := cmd.uri()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := c.doRequestContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &FilesResponse{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// fileURI builds the /api/files/{location}/{path} URI a file command acts
+// on.
+func fileURI(location Location, path string) string {
+	return fmt.Sprintf("%s/%s/%s", URIFiles, location, path)
+}
+
+// SelectFileCommand selects (and optionally starts printing) a file already
+// present on location.
+type SelectFileCommand struct {
+	Location Location
+	Path     string
+	// Print, if true, starts printing the file immediately after selecting
+	// it.
+	Print bool `json:"print"`
+}
+
+// Do sends an API request and returns an error if any.
+func (cmd *SelectFileCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *SelectFileCommand) DoContext(ctx context.Context, c *Client) error {
+	b := bytes.NewBuffer(nil)
+	if err := json.NewEncoder(b).Encode(struct {
+		Command string `json:"command"`
+		Print   bool   `json:"print"`
+	}{
+		Command: "select",
+		Print:   cmd.Print,
+	}); err != nil {
+		return err
+	}
+
+	_, err := c.doRequestContext(ctx, "POST", fileURI(cmd.Location, cmd.Path), b)
+	return err
+}
+
+// DeleteFileCommand removes a file or empty folder from location.
+type DeleteFileCommand struct {
+	Location Location
+	Path     string
+}
+
+// Do sends an API request and returns an error if any.
+func (cmd *DeleteFileCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *DeleteFileCommand) DoContext(ctx context.Context, c *Client) error {
+	_, err := c.doRequestContext(ctx, "DELETE", fileURI(cmd.Location, cmd.Path), nil)
+	return err
+}
+
+// UploadFileCommand uploads the file at LocalPath to location, optionally
+// selecting and/or printing it once the upload completes.
+type UploadFileCommand struct {
+	Location  Location
+	LocalPath string
+	// Select, if true, selects the file once uploaded.
+	Select bool
+	// Print, if true, selects and immediately starts printing the file once
+	// uploaded.
+	Print bool
+}
+
+// Do sends an API request and returns an error if any.
+func (cmd *UploadFileCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *UploadFileCommand) DoContext(ctx context.Context, c *Client) error {
+	f, err := os.Open(cmd.LocalPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreateFormFile("file", filepath.Base(cmd.LocalPath))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return err
+	}
+
+	_ = w.WriteField("select", strconv.FormatBool(cmd.Select))
+	_ = w.WriteField("print", strconv.FormatBool(cmd.Print))
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("%s/%s", URIFiles, cmd.Location)
+	_, err = c.doRequestContextWithContentType(ctx, "POST", uri, body, w.FormDataContentType())
+	return err
+}
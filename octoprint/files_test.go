@@ -0,0 +1,52 @@
+package octoprint
+
+import "testing"
+
+func TestFilesRequestURI(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     FilesRequest
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no location or path",
+			req:  FilesRequest{},
+			want: "/api/files?recursive=false",
+		},
+		{
+			name: "location only",
+			req:  FilesRequest{Location: Local},
+			want: "/api/files/local?recursive=false",
+		},
+		{
+			name: "location and path",
+			req:  FilesRequest{Location: Local, Path: "case/case.gcode", Recursive: true},
+			want: "/api/files/local/case/case.gcode?recursive=true",
+		},
+		{
+			name:    "path without location is rejected",
+			req:     FilesRequest{Path: "case.gcode"},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.req.uri()
+			if c.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Fatalf("got uri %q, want %q", got, c.want)
+			}
+		})
+	}
+}
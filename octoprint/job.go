@@ -0,0 +1,104 @@
+package octoprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// URIJob is the job sub-resource of the OctoPrint REST API.
+const URIJob = "/api/job"
+
+// JobRequest retrieves information about the current job (if any) and the
+// progress of any active print.
+type JobRequest struct{}
+
+// JobResponse is the response to a JobRequest.
+type JobResponse struct {
+	Job struct {
+		File          JobFile `json:"file"`
+		EstimatedTime float64 `json:"estimatedPrintTime"`
+	} `json:"job"`
+	Progress struct {
+		Completion    float64 `json:"completion"`
+		FilePos       int     `json:"filepos"`
+		PrintTime     float64 `json:"printTime"`
+		PrintTimeLeft float64 `json:"printTimeLeft"`
+	} `json:"progress"`
+	State string `json:"state"`
+}
+
+// JobFile identifies the file a job is printing.
+type JobFile struct {
+	Name   string `json:"name"`
+	Origin string `json:"origin"`
+	Size   int64  `json:"size"`
+}
+
+// Do sends an API request and returns the API response.
+func (cmd *JobRequest) Do(c *Client) (*JobResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *JobRequest) DoContext(ctx context.Context, c *Client) (*JobResponse, error) {
+	b, err := c.doRequestContext(ctx, "GET", URIJob, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &JobResponse{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// JobCommand starts, cancels, restarts or pauses the current print job.
+type JobCommand struct {
+	// Command is one of "start", "cancel", "restart" or "pause".
+	Command string `json:"command"`
+	// Action further qualifies a "pause" command: "pause", "resume" or
+	// "toggle". It is ignored for every other command.
+	Action string `json:"action,omitempty"`
+}
+
+// Do sends an API request and returns an error if any.
+func (cmd *JobCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *JobCommand) DoContext(ctx context.Context, c *Client) error {
+	b := bytes.NewBuffer(nil)
+	if err := cmd.encode(b); err != nil {
+		return err
+	}
+
+	_, err := c.doRequestContext(ctx, "POST", URIJob, b)
+	return err
+}
+
+func (cmd *JobCommand) encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(cmd)
+}
+
+// StartJobCommand starts the currently selected file printing.
+func StartJobCommand() *JobCommand { return &JobCommand{Command: "start"} }
+
+// CancelJobCommand cancels the currently running print.
+func CancelJobCommand() *JobCommand { return &JobCommand{Command: "cancel"} }
+
+// RestartJobCommand restarts the print from the beginning of the currently
+// selected file.
+func RestartJobCommand() *JobCommand { return &JobCommand{Command: "restart"} }
+
+// PauseJobCommand pauses, resumes or toggles the currently running print,
+// depending on action.
+func PauseJobCommand(action string) *JobCommand {
+	return &JobCommand{Command: "pause", Action: action}
+}
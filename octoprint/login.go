@@ -0,0 +1,50 @@
+package octoprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// URILogin is the login resource of the OctoPrint REST API.
+const URILogin = "/api/login"
+
+// LoginRequest exchanges the client's API key for the session cookie the
+// SockJS event channel requires. A Passive login refreshes an existing
+// session (or starts an anonymous one) without prompting for credentials,
+// which is all EventStream needs before opening the websocket.
+type LoginRequest struct {
+	Passive bool `json:"passive"`
+}
+
+// LoginResponse is the response to a LoginRequest.
+type LoginResponse struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+// Do sends an API request and returns the API response.
+func (cmd *LoginRequest) Do(c *Client) (*LoginResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *LoginRequest) DoContext(ctx context.Context, c *Client) (*LoginResponse, error) {
+	b, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequestContext(ctx, "POST", URILogin, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &LoginResponse{}
+	if err := json.Unmarshal(resp, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,33 @@
+package octoprint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoginRequestSessionCookieReachesWebsocketAuthHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == URILogin {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123", Path: "/"})
+			w.Write([]byte(`{"name":"_api","active":true}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key")
+
+	if _, err := (&LoginRequest{Passive: true}).Do(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	header := c.websocketAuthHeader(URISockJSWebsocket)
+	if header.Get("X-Api-Key") != "key" {
+		t.Fatalf("got X-Api-Key %q, want %q", header.Get("X-Api-Key"), "key")
+	}
+	if header.Get("Cookie") == "" {
+		t.Fatal("expected the session cookie from login to be forwarded")
+	}
+}
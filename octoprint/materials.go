@@ -0,0 +1,36 @@
+package octoprint
+
+// MaterialPreset maps a named material to the target temperatures it needs
+// on a single tool plus the shared heated bed.
+type MaterialPreset struct {
+	// Name identifies the preset, e.g. "PLA", "PETG", "ABS" or a
+	// user-defined custom name.
+	Name string `json:"name"`
+	// ToolTemperature is the target hotend temperature in °C.
+	ToolTemperature int `json:"toolTemperature"`
+	// BedTemperature is the target heated bed temperature in °C.
+	BedTemperature int `json:"bedTemperature"`
+}
+
+// DefaultMaterialPresets are the built-in presets offered before the user
+// adds any custom ones.
+var DefaultMaterialPresets = []MaterialPreset{
+	{Name: "PLA", ToolTemperature: 205, BedTemperature: 60},
+	{Name: "PETG", ToolTemperature: 235, BedTemperature: 80},
+	{Name: "ABS", ToolTemperature: 240, BedTemperature: 100},
+}
+
+// TargetCommand builds the TargetCommand that sets tool to this preset's
+// temperature, alongside the shared bed. includeBed should be the printer
+// profile's HeatedBed flag; printers with no heated bed reject a "bed" key
+// in the target map, so it's only included when true.
+func (p MaterialPreset) TargetCommand(tool string, includeBed bool) *TargetCommand {
+	target := map[string]int{
+		tool: p.ToolTemperature,
+	}
+	if includeBed {
+		target["bed"] = p.BedTemperature
+	}
+
+	return &TargetCommand{Target: target}
+}
@@ -0,0 +1,35 @@
+package octoprint
+
+import "testing"
+
+func TestMaterialPresetTargetCommand(t *testing.T) {
+	p := MaterialPreset{Name: "PLA", ToolTemperature: 205, BedTemperature: 60}
+
+	cmd := p.TargetCommand("tool1", true)
+
+	want := map[string]int{"tool1": 205, "bed": 60}
+	if len(cmd.Target) != len(want) {
+		t.Fatalf("got target %v, want %v", cmd.Target, want)
+	}
+	for k, v := range want {
+		if cmd.Target[k] != v {
+			t.Fatalf("got target %v, want %v", cmd.Target, want)
+		}
+	}
+}
+
+func TestMaterialPresetTargetCommandOmitsBedWhenNotHeated(t *testing.T) {
+	p := MaterialPreset{Name: "PLA", ToolTemperature: 205, BedTemperature: 60}
+
+	cmd := p.TargetCommand("tool1", false)
+
+	want := map[string]int{"tool1": 205}
+	if len(cmd.Target) != len(want) {
+		t.Fatalf("got target %v, want %v", cmd.Target, want)
+	}
+	for k, v := range want {
+		if cmd.Target[k] != v {
+			t.Fatalf("got target %v, want %v", cmd.Target, want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package octoprint
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// URIPrinterProfiles is the printer profiles sub-resource of the OctoPrint
+// REST API.
+const URIPrinterProfiles = "/api/printerprofiles"
+
+// PrinterProfilesRequest retrieves the list of configured printer profiles.
+type PrinterProfilesRequest struct{}
+
+// PrinterProfilesResponse is the response to a PrinterProfilesRequest.
+type PrinterProfilesResponse struct {
+	Profiles map[string]PrinterProfile `json:"profiles"`
+}
+
+// PrinterProfile describes one configured printer profile, including its
+// extruder layout.
+type PrinterProfile struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Current  bool   `json:"current"`
+	Default  bool   `json:"default"`
+	Extruder struct {
+		Count        int  `json:"count"`
+		SharedNozzle bool `json:"sharedNozzle"`
+	} `json:"extruder"`
+	Heatedbed bool `json:"heatedBed"`
+}
+
+// DefaultPrinterProfileRequest retrieves the printer profile OctoPrint is
+// currently using, i.e. GET /api/printerprofiles/_default.
+type DefaultPrinterProfileRequest struct{}
+
+// Do sends an API request and returns the API response.
+func (cmd *DefaultPrinterProfileRequest) Do(c *Client) (*PrinterProfile, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *DefaultPrinterProfileRequest) DoContext(ctx context.Context, c *Client) (*PrinterProfile, error) {
+	b, err := c.doRequestContext(ctx, "GET", URIPrinterProfiles+"/_default", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrinterProfile{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Do sends an API request and returns the API response.
+func (cmd *PrinterProfilesRequest) Do(c *Client) (*PrinterProfilesResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *PrinterProfilesRequest) DoContext(ctx context.Context, c *Client) (*PrinterProfilesResponse, error) {
+	b, err := c.doRequestContext(ctx, "GET", URIPrinterProfiles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PrinterProfilesResponse{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
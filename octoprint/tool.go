@@ -2,13 +2,62 @@ package octoprint
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 )
 
 const URITool = "/api/printer/tool"
 
+// URIPrintHead is the print head sub-resource of the OctoPrint REST API,
+// used for jogging, homing and feedrate commands.
+const URIPrintHead = "/api/printer/printhead"
+
+// ErrOutOfRange is returned by commands that validate a numeric field
+// against an inclusive range before sending it to OctoPrint.
+type ErrOutOfRange struct {
+	Field    string
+	Value    int
+	Min, Max int
+}
+
+func (e *ErrOutOfRange) Error() string {
+	return fmt.Sprintf("%s must be between %d and %d, got %d", e.Field, e.Min, e.Max, e.Value)
+}
+
+// postCommand encodes payload as the JSON body of the named command and
+// POSTs it to uri, merging in the "command" field the way every
+// /api/printer/* sub-resource expects. It factors out the encode/doRequest
+// boilerplate shared by the /api/printer/* commands in this file so new ones
+// (e.g. a future GCodeScriptCommand) are one-liners. The job, connection and
+// file commands encode and send their own bodies.
+func postCommand(ctx context.Context, c *Client, uri, name string, payload interface{}) error {
+	fields, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(fields, &merged); err != nil {
+		return err
+	}
+	merged["command"] = name
+
+	b := bytes.NewBuffer(nil)
+	if err := json.NewEncoder(b).Encode(merged); err != nil {
+		return err
+	}
+
+	_, err = c.doRequestContext(ctx, "POST", uri, b)
+	return err
+}
+
+// postToolCommand is postCommand scoped to URITool, which every command in
+// this file but FeedrateCommand targets.
+func postToolCommand(ctx context.Context, c *Client, name string, payload interface{}) error {
+	return postCommand(ctx, c, URITool, name, payload)
+}
+
 // ToolCommand retrieves the current temperature data (actual, target and
 // offset) plus optionally a (limited) history (actual, target, timestamp) for
 // all of the printer’s available tools.
@@ -49,8 +98,14 @@ func (r *ToolResponse) UnmarshalJSON(b []byte) error {
 
 // Do sends an API request and returns the API response.
 func (cmd *ToolCommand) Do(c *Client) (*ToolResponse, error) {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns the API response, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *ToolCommand) DoContext(ctx context.Context, c *Client) (*ToolResponse, error) {
 	uri := fmt.Sprintf("%s?history=%t&limit=%d", URITool, cmd.History, cmd.Limit)
-	b, err := c.doRequest("GET", uri, nil)
+	b, err := c.doRequestContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -72,23 +127,13 @@ type TargetCommand struct {
 
 // Do sends an API request and returns an error if any.
 func (cmd *TargetCommand) Do(c *Client) error {
-	b := bytes.NewBuffer(nil)
-	if err := cmd.encode(b); err != nil {
-		return err
-	}
-
-	_, err := c.doRequest("POST", URITool, b)
-	return err
+	return cmd.DoContext(context.Background(), c)
 }
 
-func (cmd *TargetCommand) encode(w io.Writer) error {
-	return json.NewEncoder(w).Encode(struct {
-		Command string `json:"command"`
-		TargetCommand
-	}{
-		Command:       "target",
-		TargetCommand: *cmd,
-	})
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *TargetCommand) DoContext(ctx context.Context, c *Client) error {
+	return postToolCommand(ctx, c, "target", cmd)
 }
 
 // OffsetCommand sets the given temperature offset on the printer’s tools.
@@ -100,23 +145,13 @@ type OffsetCommand struct {
 
 // Do sends an API request and returns an error if any.
 func (cmd *OffsetCommand) Do(c *Client) error {
-	b := bytes.NewBuffer(nil)
-	if err := cmd.encode(b); err != nil {
-		return err
-	}
-
-	_, err := c.doRequest("POST", URITool, b)
-	return err
+	return cmd.DoContext(context.Background(), c)
 }
 
-func (cmd *OffsetCommand) encode(w io.Writer) error {
-	return json.NewEncoder(w).Encode(struct {
-		Command string `json:"command"`
-		OffsetCommand
-	}{
-		Command:       "offset",
-		OffsetCommand: *cmd,
-	})
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *OffsetCommand) DoContext(ctx context.Context, c *Client) error {
+	return postToolCommand(ctx, c, "offset", cmd)
 }
 
 // ExtrudeCommand extrudes the given amount of filament from the currently
@@ -129,23 +164,13 @@ type ExtrudeCommand struct {
 
 // Do sends an API request and returns an error if any.
 func (cmd *ExtrudeCommand) Do(c *Client) error {
-	b := bytes.NewBuffer(nil)
-	if err := cmd.encode(b); err != nil {
-		return err
-	}
-
-	_, err := c.doRequest("POST", URITool, b)
-	return err
+	return cmd.DoContext(context.Background(), c)
 }
 
-func (cmd *ExtrudeCommand) encode(w io.Writer) error {
-	return json.NewEncoder(w).Encode(struct {
-		Command string `json:"command"`
-		ExtrudeCommand
-	}{
-		Command:        "extrude",
-		ExtrudeCommand: *cmd,
-	})
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *ExtrudeCommand) DoContext(ctx context.Context, c *Client) error {
+	return postToolCommand(ctx, c, "extrude", cmd)
 }
 
 // SelectCommand selects the printer’s current tool.
@@ -157,48 +182,59 @@ type SelectCommand struct {
 
 // Do sends an API request and returns an error if any.
 func (cmd *SelectCommand) Do(c *Client) error {
-	b := bytes.NewBuffer(nil)
-	if err := cmd.encode(b); err != nil {
-		return err
-	}
-
-	_, err := c.doRequest("POST", URITool, b)
-	return err
+	return cmd.DoContext(context.Background(), c)
 }
 
-func (cmd *SelectCommand) encode(w io.Writer) error {
-	return json.NewEncoder(w).Encode(struct {
-		Command string `json:"command"`
-		SelectCommand
-	}{
-		Command:       "select",
-		SelectCommand: *cmd,
-	})
+// DoContext sends an API request and returns an error if any, aborting if
+// ctx is canceled or its deadline expires before a response is received.
+func (cmd *SelectCommand) DoContext(ctx context.Context, c *Client) error {
+	return postToolCommand(ctx, c, "select", cmd)
 }
 
-// FlowrateCommand changes the flow rate factor to apply to extrusion of the tool.
+// FlowrateCommand changes the flow rate factor to apply to extrusion of the
+// tool.
 type FlowrateCommand struct {
 	// Factor is the new factor, percentage as integer, between 75 and 125%.
-	Factor string `json:"factor"`
+	Factor int `json:"factor"`
 }
 
-// Do sends an API request and returns an error if any.
+// Do sends an API request and returns an error if any, including
+// *ErrOutOfRange if Factor is outside [75, 125].
 func (cmd *FlowrateCommand) Do(c *Client) error {
-	b := bytes.NewBuffer(nil)
-	if err := cmd.encode(b); err != nil {
-		return err
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, including
+// *ErrOutOfRange if Factor is outside [75, 125], aborting if ctx is canceled
+// or its deadline expires before a response is received.
+func (cmd *FlowrateCommand) DoContext(ctx context.Context, c *Client) error {
+	if cmd.Factor < 75 || cmd.Factor > 125 {
+		return &ErrOutOfRange{Field: "Factor", Value: cmd.Factor, Min: 75, Max: 125}
 	}
 
-	_, err := c.doRequest("POST", URITool, b)
-	return err
+	return postToolCommand(ctx, c, "flowrate", cmd)
 }
 
-func (cmd *FlowrateCommand) encode(w io.Writer) error {
-	return json.NewEncoder(w).Encode(struct {
-		Command string `json:"command"`
-		FlowrateCommand
-	}{
-		Command:         "flowrate",
-		FlowrateCommand: *cmd,
-	})
-}
\ No newline at end of file
+// FeedrateCommand changes the feedrate factor to apply to the print head's
+// movements.
+type FeedrateCommand struct {
+	// Factor is the new factor, percentage as integer, between 50 and 200%.
+	Factor int `json:"factor"`
+}
+
+// Do sends an API request and returns an error if any, including
+// *ErrOutOfRange if Factor is outside [50, 200].
+func (cmd *FeedrateCommand) Do(c *Client) error {
+	return cmd.DoContext(context.Background(), c)
+}
+
+// DoContext sends an API request and returns an error if any, including
+// *ErrOutOfRange if Factor is outside [50, 200], aborting if ctx is canceled
+// or its deadline expires before a response is received.
+func (cmd *FeedrateCommand) DoContext(ctx context.Context, c *Client) error {
+	if cmd.Factor < 50 || cmd.Factor > 200 {
+		return &ErrOutOfRange{Field: "Factor", Value: cmd.Factor, Min: 50, Max: 200}
+	}
+
+	return postCommand(ctx, c, URIPrintHead, "feedrate", cmd)
+}
@@ -0,0 +1,76 @@
+package octoprint
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlowrateCommandValidation(t *testing.T) {
+	cases := []struct {
+		factor  int
+		wantErr bool
+	}{
+		{factor: 74, wantErr: true},
+		{factor: 75, wantErr: false},
+		{factor: 125, wantErr: false},
+		{factor: 126, wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := (&FlowrateCommand{Factor: c.factor}).Do(NewClient("http://example.invalid", "key"))
+		_, isOutOfRange := err.(*ErrOutOfRange)
+		if c.wantErr != isOutOfRange {
+			t.Errorf("factor %d: got err %v, wantErr %v", c.factor, err, c.wantErr)
+		}
+	}
+}
+
+func TestFeedrateCommandValidation(t *testing.T) {
+	cases := []struct {
+		factor  int
+		wantErr bool
+	}{
+		{factor: 49, wantErr: true},
+		{factor: 50, wantErr: false},
+		{factor: 200, wantErr: false},
+		{factor: 201, wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := (&FeedrateCommand{Factor: c.factor}).Do(NewClient("http://example.invalid", "key"))
+		_, isOutOfRange := err.(*ErrOutOfRange)
+		if c.wantErr != isOutOfRange {
+			t.Errorf("factor %d: got err %v, wantErr %v", c.factor, err, c.wantErr)
+		}
+	}
+}
+
+func TestPostCommandMergesCommandField(t *testing.T) {
+	var gotBody map[string]interface{}
+	var gotURI string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURI = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key")
+	if err := (&TargetCommand{Target: map[string]int{"tool0": 200}}).Do(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotURI != URITool {
+		t.Fatalf("got uri %q, want %q", gotURI, URITool)
+	}
+	if gotBody["command"] != "target" {
+		t.Fatalf("got command %v, want \"target\"", gotBody["command"])
+	}
+	target, ok := gotBody["target"].(map[string]interface{})
+	if !ok || target["tool0"] != float64(200) {
+		t.Fatalf("got target %v, want {tool0: 200}", gotBody["target"])
+	}
+}
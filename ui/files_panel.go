@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+// filesPanel lists the files available on Local storage and lets the user
+// select one to print.
+type filesPanel struct {
+	CommonPanel
+
+	files []octoprint.FileInformation
+}
+
+// FilesPanel creates a panel listing the files in OctoPrint's Local storage
+// and lets the user start a print directly from the touchscreen. Files on
+// the printer's SD card are not listed.
+func FilesPanel(ui *UI, parent Panel) Panel {
+	m := &filesPanel{CommonPanel: NewCommonPanel(ui, parent)}
+	m.initialize()
+	return m
+}
+
+func (m *filesPanel) initialize() {
+	defer m.Initialize()
+
+	resp, err := (&octoprint.FilesRequest{Location: octoprint.Local, Recursive: true}).Do(m.UI.Client)
+	if err != nil {
+		m.UI.ShowToast(err.Error())
+		return
+	}
+
+	m.files = resp.Files
+	for i, f := range m.files {
+		file := f
+		m.Grid().Attach(MustButtonImageStyle(file.Name, "file.svg", "color1", func() {
+			m.selectAndPrint(file)
+		}), i%4, i/4, 1, 1)
+	}
+}
+
+func (m *filesPanel) selectAndPrint(file octoprint.FileInformation) {
+	cmd := &octoprint.SelectFileCommand{
+		Location: octoprint.Local,
+		Path:     file.Path,
+		Print:    true,
+	}
+
+	if err := cmd.Do(m.UI.Client); err != nil {
+		m.UI.ShowToast(err.Error())
+		return
+	}
+
+	m.UI.Add(JobPanel(m.UI, m))
+}
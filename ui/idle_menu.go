@@ -24,6 +24,8 @@ func (m *idleMenuPanel) initialize() {
 	m.Grid().Attach(MustButtonImageStyle("Control", "control.svg", "color4", m.showControl), 3, 0, 1, 1)
 	m.Grid().Attach(MustButtonImageStyle("System", "settings.svg", "color3", m.showSystem), 4, 0, 1, 1)
 	m.Grid().Attach(MustButtonImageStyle("Temperature", "heat-up.svg", "color4", m.showTemperature), 1, 1, 1, 1)
+	m.Grid().Attach(MustButtonImageStyle("Files", "files.svg", "color1", m.showFiles), 2, 1, 1, 1)
+	m.Grid().Attach(MustButtonImageStyle("Job", "job.svg", "color2", m.showJob), 3, 1, 1, 1)
 }
 
 func (m *idleMenuPanel) showTemperature() {
@@ -45,3 +47,11 @@ func (m *idleMenuPanel) showToolchanger() {
 func (m *idleMenuPanel) showSystem() {
 	m.UI.Add(SystemPanel(m.UI, m))
 }
+
+func (m *idleMenuPanel) showFiles() {
+	m.UI.Add(FilesPanel(m.UI, m))
+}
+
+func (m *idleMenuPanel) showJob() {
+	m.UI.Add(JobPanel(m.UI, m))
+}
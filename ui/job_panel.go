@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+// jobPanel lets the user start, pause/resume and cancel the active print
+// job without leaving the touchscreen.
+type jobPanel struct {
+	CommonPanel
+}
+
+// JobPanel creates a panel with controls to start, pause/resume or cancel
+// the current job. It does not display the job's state or progress.
+func JobPanel(ui *UI, parent Panel) Panel {
+	m := &jobPanel{CommonPanel: NewCommonPanel(ui, parent)}
+	m.initialize()
+	return m
+}
+
+func (m *jobPanel) initialize() {
+	defer m.Initialize()
+
+	m.Grid().Attach(MustButtonImageStyle("Start", "resume.svg", "color2", m.start), 1, 0, 1, 1)
+	m.Grid().Attach(MustButtonImageStyle("Pause", "pause.svg", "color3", m.pause), 2, 0, 1, 1)
+	m.Grid().Attach(MustButtonImageStyle("Cancel", "cancel.svg", "color1", m.cancel), 3, 0, 1, 1)
+}
+
+func (m *jobPanel) start() {
+	m.do(octoprint.StartJobCommand())
+}
+
+func (m *jobPanel) pause() {
+	m.do(octoprint.PauseJobCommand("toggle"))
+}
+
+func (m *jobPanel) cancel() {
+	m.do(octoprint.CancelJobCommand())
+}
+
+func (m *jobPanel) do(cmd *octoprint.JobCommand) {
+	if err := cmd.Do(m.UI.Client); err != nil {
+		m.UI.ShowToast(err.Error())
+	}
+}
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+// materialPresetsPath is where the user's material presets, and their
+// per-tool selection, are persisted between restarts.
+var materialPresetsPath = filepath.Join(os.Getenv("HOME"), ".octoscreen", "materials.json")
+
+// materialPresetsConfig is the on-disk shape of materialPresetsPath.
+type materialPresetsConfig struct {
+	Presets []octoprint.MaterialPreset `json:"presets"`
+	// Selected maps a tool key (e.g. "tool0") to the name of the preset
+	// last chosen for it, so the Temperature panel can restore the
+	// dropdown's selection across restarts.
+	Selected map[string]string `json:"selected"`
+}
+
+// loadMaterialPresetsConfig reads materialPresetsPath, falling back to
+// DefaultMaterialPresets if the file doesn't exist yet.
+func loadMaterialPresetsConfig() *materialPresetsConfig {
+	cfg := &materialPresetsConfig{
+		Presets:  octoprint.DefaultMaterialPresets,
+		Selected: map[string]string{},
+	}
+
+	b, err := ioutil.ReadFile(materialPresetsPath)
+	if err != nil {
+		return cfg
+	}
+
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return cfg
+	}
+
+	return cfg
+}
+
+// save persists cfg to materialPresetsPath.
+func (cfg *materialPresetsConfig) save() error {
+	if err := os.MkdirAll(filepath.Dir(materialPresetsPath), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(materialPresetsPath, b, 0644)
+}
+
+// preset looks up a preset by name.
+func (cfg *materialPresetsConfig) preset(name string) (octoprint.MaterialPreset, bool) {
+	for _, p := range cfg.Presets {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return octoprint.MaterialPreset{}, false
+}
+
+// selectPreset records name as the active preset for tool and persists the
+// change.
+func (cfg *materialPresetsConfig) selectPreset(tool, name string) error {
+	cfg.Selected[tool] = name
+	return cfg.save()
+}
+
+// selectedPreset returns the preset last chosen for tool, restoring it from
+// the persisted selection. It reports false if tool has no remembered
+// selection, or if that selection no longer matches a configured preset.
+func (cfg *materialPresetsConfig) selectedPreset(tool string) (octoprint.MaterialPreset, bool) {
+	name, ok := cfg.Selected[tool]
+	if !ok {
+		return octoprint.MaterialPreset{}, false
+	}
+
+	return cfg.preset(name)
+}
+
+// effectivePreset returns the preset currently in effect for tool: the
+// persisted selection if there is one, otherwise the first configured
+// preset. It reports false only when there is no preset to fall back to at
+// all, i.e. no presets are configured. Both the Temperature panel's initial
+// render and its cycle-to-next-preset logic go through this so they agree
+// on what "no selection yet" means.
+func (cfg *materialPresetsConfig) effectivePreset(tool string) (octoprint.MaterialPreset, bool) {
+	if preset, ok := cfg.selectedPreset(tool); ok {
+		return preset, true
+	}
+
+	if len(cfg.Presets) == 0 {
+		return octoprint.MaterialPreset{}, false
+	}
+
+	return cfg.Presets[0], true
+}
+
+// toolKey formats the tool index the way the OctoPrint API expects,
+// matching TargetCommand/OffsetCommand's "tool{n}" keys.
+func toolKey(index int) string {
+	return fmt.Sprintf("tool%d", index)
+}
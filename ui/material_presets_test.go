@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+func newTestMaterialPresetsConfig() *materialPresetsConfig {
+	return &materialPresetsConfig{
+		Presets:  octoprint.DefaultMaterialPresets,
+		Selected: map[string]string{},
+	}
+}
+
+func TestMaterialPresetsConfigPreset(t *testing.T) {
+	cfg := newTestMaterialPresetsConfig()
+
+	if _, ok := cfg.preset("PLA"); !ok {
+		t.Fatal("expected the built-in PLA preset to be found")
+	}
+	if _, ok := cfg.preset("unknown"); ok {
+		t.Fatal("expected an unknown preset name to not be found")
+	}
+}
+
+func TestMaterialPresetsConfigSelectedPreset(t *testing.T) {
+	cfg := newTestMaterialPresetsConfig()
+	cfg.Selected["tool0"] = "PETG"
+
+	preset, ok := cfg.selectedPreset("tool0")
+	if !ok || preset.Name != "PETG" {
+		t.Fatalf("got %v, %v; want PETG, true", preset, ok)
+	}
+
+	if _, ok := cfg.selectedPreset("tool1"); ok {
+		t.Fatal("expected tool1 to have no remembered selection")
+	}
+
+	cfg.Selected["tool2"] = "does-not-exist"
+	if _, ok := cfg.selectedPreset("tool2"); ok {
+		t.Fatal("expected a stale selection naming a removed preset to report false")
+	}
+}
+
+func TestMaterialPresetsConfigEffectivePreset(t *testing.T) {
+	cfg := newTestMaterialPresetsConfig()
+	cfg.Selected["tool0"] = "PETG"
+
+	preset, ok := cfg.effectivePreset("tool0")
+	if !ok || preset.Name != "PETG" {
+		t.Fatalf("got %v, %v; want PETG, true", preset, ok)
+	}
+
+	preset, ok = cfg.effectivePreset("tool1")
+	if !ok || preset.Name != cfg.Presets[0].Name {
+		t.Fatalf("got %v, %v; want %v, true", preset, ok, cfg.Presets[0].Name)
+	}
+
+	cfg.Presets = nil
+	if _, ok := cfg.effectivePreset("tool2"); ok {
+		t.Fatal("expected no fallback when no presets are configured")
+	}
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// temperaturePanel renders one row per detected tool, each with a button
+// that cycles through the configured material presets and issues the
+// matching TargetCommand in a single request. The last preset picked for
+// each tool is remembered across restarts.
+type temperaturePanel struct {
+	CommonPanel
+
+	profile *ToolProfile
+	cfg     *materialPresetsConfig
+	buttons map[string]*gtk.Button
+}
+
+// TemperaturePanel creates the panel used to set tool and bed temperatures
+// from material presets. It does not read or display current temperatures.
+func TemperaturePanel(ui *UI, parent Panel) Panel {
+	m := &temperaturePanel{CommonPanel: NewCommonPanel(ui, parent)}
+	m.initialize()
+	return m
+}
+
+func (m *temperaturePanel) initialize() {
+	defer m.Initialize()
+
+	m.cfg = loadMaterialPresetsConfig()
+	m.buttons = map[string]*gtk.Button{}
+
+	profile, err := DetectToolProfile(m.UI.Client)
+	if err != nil {
+		m.UI.ShowToast(err.Error())
+		profile = &ToolProfile{Tools: []string{toolKey(0)}}
+	}
+	m.profile = profile
+
+	for row, t := range m.profile.Tools {
+		tool := t
+		button := MustButtonImageStyle(m.label(tool), "heat-up.svg", "color4", func() {
+			m.cycle(tool)
+		})
+		m.buttons[tool] = button
+		m.Grid().Attach(button, 1, row, 1, 1)
+	}
+}
+
+// label formats the button text for tool from its currently effective
+// preset (see materialPresetsConfig.effectivePreset).
+func (m *temperaturePanel) label(tool string) string {
+	preset, _ := m.cfg.effectivePreset(tool)
+	return fmt.Sprintf("%s: %s", tool, preset.Name)
+}
+
+// cycle advances tool to its next material preset, applies it, persists the
+// selection and refreshes the button's label to reflect it.
+func (m *temperaturePanel) cycle(tool string) {
+	current, ok := m.cfg.effectivePreset(tool)
+	if !ok {
+		return
+	}
+
+	next := m.cfg.Presets[0]
+	for i, p := range m.cfg.Presets {
+		if p.Name == current.Name {
+			next = m.cfg.Presets[(i+1)%len(m.cfg.Presets)]
+			break
+		}
+	}
+
+	cmd := next.TargetCommand(tool, m.profile.HeatedBed)
+	if m.profile.SharedNozzle {
+		// tool and the other entries in m.profile.Tools share one physical
+		// nozzle, so heating tool while another is still targeted would
+		// fight over it. Turn the others off in the same request.
+		for _, other := range m.profile.Tools {
+			if other != tool {
+				cmd.Target[other] = 0
+			}
+		}
+	}
+
+	if err := cmd.Do(m.UI.Client); err != nil {
+		m.UI.ShowToast(err.Error())
+		return
+	}
+
+	if err := m.cfg.selectPreset(tool, next.Name); err != nil {
+		m.UI.ShowToast(err.Error())
+		return
+	}
+
+	if button, ok := m.buttons[tool]; ok {
+		button.SetLabel(m.label(tool))
+	}
+}
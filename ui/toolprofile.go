@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+// detectToolProfileTimeout bounds DetectToolProfile's request, so a stuck
+// OctoPrint server can't freeze the touchscreen while the Temperature panel
+// is loading. Commands the panels send through Do are bounded only by the
+// client's default timeout.
+const detectToolProfileTimeout = 5 * time.Second
+
+// ToolProfile describes the tools the Temperature panel should render rows
+// for, resolved from the printer's default profile rather than assumed to
+// always be a single "tool0".
+type ToolProfile struct {
+	// Tools are the tool keys to render, in order ("tool0", "tool1", ...).
+	Tools []string
+	// SharedNozzle is true when all tools in Tools share a single nozzle,
+	// meaning only one of them can be heated at a time.
+	SharedNozzle bool
+	// HeatedBed is true when the printer profile reports a heated bed, so
+	// the Temperature panel knows whether to include "bed" in a
+	// MaterialPreset's TargetCommand.
+	HeatedBed bool
+}
+
+// DetectToolProfile queries the printer's default profile and returns the
+// ToolProfile the Temperature panel should render.
+func DetectToolProfile(c *octoprint.Client) (*ToolProfile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), detectToolProfileTimeout)
+	defer cancel()
+
+	profile, err := (&octoprint.DefaultPrinterProfileRequest{}).DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	count := profile.Extruder.Count
+	if count < 1 {
+		count = 1
+	}
+
+	tools := make([]string, count)
+	for i := range tools {
+		tools[i] = toolKey(i)
+	}
+
+	return &ToolProfile{
+		Tools:        tools,
+		SharedNozzle: profile.Extruder.SharedNozzle,
+		HeatedBed:    profile.Heatedbed,
+	}, nil
+}
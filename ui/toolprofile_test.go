@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/OevreFlataeker/OctoScreen/octoprint"
+)
+
+func defaultProfileServer(t *testing.T, body string) *octoprint.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return octoprint.NewClient(srv.URL, "key")
+}
+
+func TestDetectToolProfileFallsBackToOneTool(t *testing.T) {
+	c := defaultProfileServer(t, `{"id":"_default","extruder":{"count":0,"sharedNozzle":false}}`)
+
+	profile, err := DetectToolProfile(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(profile.Tools) != 1 || profile.Tools[0] != "tool0" {
+		t.Fatalf("got tools %v, want [tool0]", profile.Tools)
+	}
+}
+
+func TestDetectToolProfileMultipleTools(t *testing.T) {
+	c := defaultProfileServer(t, `{"id":"_default","extruder":{"count":2,"sharedNozzle":true}}`)
+
+	profile, err := DetectToolProfile(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(profile.Tools) != 2 || profile.Tools[0] != "tool0" || profile.Tools[1] != "tool1" {
+		t.Fatalf("got tools %v, want [tool0 tool1]", profile.Tools)
+	}
+	if !profile.SharedNozzle {
+		t.Fatal("expected SharedNozzle to be true")
+	}
+}
+
+func TestDetectToolProfileHeatedBed(t *testing.T) {
+	c := defaultProfileServer(t, `{"id":"_default","extruder":{"count":1,"sharedNozzle":false},"heatedBed":true}`)
+
+	profile, err := DetectToolProfile(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !profile.HeatedBed {
+		t.Fatal("expected HeatedBed to be true")
+	}
+}